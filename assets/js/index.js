@@ -112,6 +112,27 @@ Source:
     );
   {{ end -}}
 
+  // SecLang entities documented as headings of a reference page get their
+  // own entry, directives are already indexed as pages. Exact name matches
+  // of any entity are listed first.
+  var entities = new Map();
+
+  {{ range $index, $entity := partialCached "seclang/entities.html" . "entities" -}}
+    {{ $doc := dict "href" $entity.url "title" $entity.name "description" (or $entity.summary $entity.kind) -}}
+    entities.set({{ lower $entity.name | jsonify }}, {{ $doc | jsonify }});
+    {{ if ne $entity.kind "directive" -}}
+    index.add(
+      {
+        id: {{ add $len $index }},
+        href: {{ $entity.url | jsonify }},
+        title: {{ $entity.name | jsonify }},
+        description: {{ $doc.description | jsonify }},
+        content: {{ printf "%s %s" $entity.kind $entity.summary | jsonify }}
+      }
+    );
+    {{ end -}}
+  {{ end -}}
+
   search.addEventListener('input', show_results, true);
 
   function show_results(){
@@ -121,9 +142,12 @@ Source:
 
     // flatten results since index.search() returns results for each indexed field
     const flatResults = new Map(); // keyed by href to dedupe results
+    const exact = entities.get(searchQuery.trim().replace(/^@/, '').toLowerCase());
+    if (exact) flatResults.set(exact.href, exact);
     for (const result of results.flatMap(r => r.result)) {
       if (flatResults.has(result.doc.href)) continue;
       flatResults.set(result.doc.href, result.doc);
+      if (flatResults.size === maxResult) break;
     }
 
     suggestions.innerHTML = "";
@@ -0,0 +1,57 @@
+import * as params from '@params';
+
+// Keyword lists come from the entities documented on the site, see the
+// seclang/entities partial, so new reference entries are highlighted too
+// and misspelled directives are not. Core directives without a page are
+// seeded from data/seclang/core-directives.yaml.
+var entities = params.seclang || {};
+
+function words(kind) {
+  return (entities[kind] || []).map(function(word) {
+    return word.replace(/[.*+?^${}()|[\]\\]/g, '\\$&');
+  }).join('|');
+}
+
+function mode(className, before, kind, after) {
+  var list = words(kind);
+  if (list === '') {
+    return null;
+  }
+  return {
+    className: className,
+    begin: before + '(' + list + ')' + after,
+  };
+}
+
+export default function seclang(hljs) {
+  var variables = mode('variable', '\\b', 'variable', '\\b');
+  var inner = [
+    hljs.BACKSLASH_ESCAPE,
+    mode('symbol', '@', 'operator', '\\b'),
+    mode('attr', '\\bt:', 'transformation', '\\b'),
+    mode('built_in', '\\b', 'action', '\\b'),
+    variables,
+  ].filter(Boolean);
+
+  return {
+    name: 'SecLang',
+    aliases: ['apache', 'modsecurity'],
+    case_insensitive: false,
+    contains: [
+      hljs.HASH_COMMENT_MODE,
+      mode('keyword', '^\\s*', 'directive', '\\b'),
+      {
+        className: 'string',
+        begin: '"',
+        end: '"',
+        contains: inner,
+      },
+      {
+        className: 'string',
+        begin: '\'',
+        end: '\'',
+        contains: inner,
+      },
+    ].concat(variables ? [variables] : []).filter(Boolean),
+  };
+}